@@ -0,0 +1,100 @@
+// Copyright 2022 exl Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tealeg/xlsx/v3"
+)
+
+type (
+	// FormulaHandling controls how cells containing a formula are read.
+	FormulaHandling uint8
+	// Formula is the raw, unevaluated expression of a formula cell, as
+	// unmarshaled into a field when FormulaHandling is FormulaAsExpression.
+	Formula string
+	// FormulaEvaluator computes the value of a formula cell when neither
+	// tealeg's cached value nor its formatted value is available.
+	// Implementations can wire in an external calculation engine
+	// (e.g. excelize's calc) without this module taking on that dependency.
+	FormulaEvaluator interface {
+		Evaluate(sheet, ref, formula string) (string, error)
+	}
+)
+
+const (
+	// Use whatever cached string tealeg produced for the cell (the default).
+	FormulaUseCached FormulaHandling = iota
+	// Use tealeg's cached result when the cell has one, reformatting it via
+	// cell.FormattedValue (this does not recompute the formula: a cell
+	// saved without a cached result, e.g. written by a non-Excel tool,
+	// still needs ReadConfig.FormulaEvaluator to produce a value at all).
+	FormulaEvaluate
+	// Unmarshal the raw formula string itself, for a destination field of type Formula.
+	FormulaAsExpression
+)
+
+// ErrNoFormulaEvaluator is returned when FormulaHandling is FormulaEvaluate,
+// a formula cell has no cached or formatted value, and ReadConfig.FormulaEvaluator is nil.
+var ErrNoFormulaEvaluator = fmt.Errorf("exl: formula cell has no cached value and no FormulaEvaluator is configured")
+
+// resolveFormulaCell returns the *xlsx.Cell an UnmarshalExcelFunc should
+// read from, honoring rc.FormulaHandling for cells containing a formula.
+// It also returns the cell's raw formula string, for FieldError.Formula.
+func resolveFormulaCell(cell *xlsx.Cell, sheetName string, rowIndex, colIndex int, rc *ReadConfig) (resolved *xlsx.Cell, formula string, err error) {
+	formula = cell.Formula()
+	if formula == "" || rc.FormulaHandling == FormulaUseCached {
+		return cell, formula, nil
+	}
+
+	switch rc.FormulaHandling {
+	case FormulaAsExpression:
+		return &xlsx.Cell{Value: formula}, formula, nil
+	case FormulaEvaluate:
+		// A non-empty cell.Value means tealeg already has a cached result
+		// for this formula; prefer it (reformatted) over involving the
+		// evaluator at all, and don't let a number-format parse error from
+		// FormattedValue discard a perfectly good cached value.
+		if cell.Value != "" {
+			if v, fvErr := cell.FormattedValue(); fvErr == nil && v != "" {
+				return &xlsx.Cell{Value: v}, formula, nil
+			}
+			return &xlsx.Cell{Value: cell.Value}, formula, nil
+		}
+		if rc.FormulaEvaluator == nil {
+			return nil, formula, ErrNoFormulaEvaluator
+		}
+		v, err := rc.FormulaEvaluator.Evaluate(sheetName, cellReference(rowIndex, colIndex), formula)
+		if err != nil {
+			return nil, formula, err
+		}
+		return &xlsx.Cell{Value: v}, formula, nil
+	default:
+		return cell, formula, nil
+	}
+}
+
+// cellReference renders a 0-based row/column pair in A1 notation, e.g. (0, 2) -> "C1".
+func cellReference(rowIndex, colIndex int) string {
+	var letters strings.Builder
+	for n := colIndex + 1; n > 0; n = (n - 1) / 26 {
+		letters.WriteByte(byte('A' + (n-1)%26))
+	}
+	letterBytes := []byte(letters.String())
+	for i, j := 0, len(letterBytes)-1; i < j; i, j = i+1, j-1 {
+		letterBytes[i], letterBytes[j] = letterBytes[j], letterBytes[i]
+	}
+	return string(letterBytes) + strconv.Itoa(rowIndex+1)
+}
@@ -0,0 +1,243 @@
+// Copyright 2022 exl Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exl
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/tealeg/xlsx/v3"
+)
+
+// SheetError is one sheet's failure within a WorkbookError.
+type SheetError struct {
+	SheetIndex int
+	SheetName  string
+	Err        error
+}
+
+// Error implements error.
+func (e SheetError) Error() string {
+	return fmt.Sprintf("error reading sheet \"%s\" at index %d: %s", e.SheetName, e.SheetIndex, e.Err.Error())
+}
+
+// Unwrap implements the anonymous unwrap interface used by errors.Unwrap and others.
+func (e SheetError) Unwrap() error {
+	return e.Err
+}
+
+// WorkbookError aggregates the per-sheet errors from ReadAll/ReadMulti.
+// Sheets that read successfully are still returned alongside it.
+type WorkbookError struct {
+	SheetErrors []SheetError
+}
+
+// Error implements error.
+func (e WorkbookError) Error() string {
+	return fmt.Sprintf("%d sheets failed to read from Excel", len(e.SheetErrors))
+}
+
+// Unwrap implements the anonymous unwrap interface used by errors.Unwrap and others.
+func (e WorkbookError) Unwrap() []error {
+	errs := make([]error, len(e.SheetErrors))
+	for i, v := range e.SheetErrors {
+		errs[i] = v
+	}
+	return errs
+}
+
+var (
+	// Ensure SheetError implements the error interface
+	_ error = SheetError{}
+	// Ensure SheetError can be unwrapped
+	_ interface {
+		Unwrap() error
+	} = SheetError{}
+	// Ensure WorkbookError implements the error interface
+	_ error = WorkbookError{}
+)
+
+func findSheetByName(f *xlsx.File, name string) (int, bool) {
+	for i, sheet := range f.Sheets {
+		if strings.EqualFold(sheet.Name, name) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// findSheetByPattern returns the index of the first sheet whose name matches pattern.
+func findSheetByPattern(f *xlsx.File, pattern *regexp.Regexp) (int, bool) {
+	for i, sheet := range f.Sheets {
+		if pattern.MatchString(sheet.Name) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// ReadAll reads every sheet of reader whose name matches predicate into a
+// map keyed by sheet name, sharing a single parse of the underlying
+// xlsx.File. A nil predicate matches every sheet.
+// T's own ReadConfigure still controls header/tag resolution as usual, but
+// its SheetIndex/SheetName are overridden per matched sheet.
+// Sheets that fail to read are collected into a WorkbookError rather than
+// aborting the whole read; the successfully read sheets are still returned.
+func ReadAll[T ReadConfigurator](reader io.Reader, predicate func(sheetName string) bool, filterFunc ...func(t T) (add bool)) (map[string][]T, error) {
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	f, err := xlsx.OpenBinary(b)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]T)
+	var sheetErrors []SheetError
+	for i, sheet := range f.Sheets {
+		if predicate != nil && !predicate(sheet.Name) {
+			continue
+		}
+
+		var t T
+		rc := defaultReadConfig()
+		t.ReadConfigure(rc)
+		rc.SheetName = ""
+		rc.SheetIndex = i
+
+		ts, err := readWithConfig[T](f, rc, filterFunc...)
+		if err != nil {
+			sheetErrors = append(sheetErrors, SheetError{SheetIndex: i, SheetName: sheet.Name, Err: err})
+			continue
+		}
+		result[sheet.Name] = ts
+	}
+	if len(sheetErrors) > 0 {
+		return result, WorkbookError{SheetErrors: sheetErrors}
+	}
+	return result, nil
+}
+
+// SheetTarget binds a sheet selector (index, name, or name pattern) to a
+// reader for a distinct target type, for use with ReadMulti. Build one with
+// Target or TargetPattern.
+type SheetTarget struct {
+	sheetIndex   int
+	sheetName    string
+	sheetPattern *regexp.Regexp
+	read         func(f *xlsx.File, sheetIndex int) error
+}
+
+// Target builds a SheetTarget that reads the sheet selected by sheetIndex
+// (or, if sheetName is non-empty, the sheet of that name instead) into dest,
+// for use with ReadMulti. Rows matching filterFunc are kept, same as Read.
+func Target[T ReadConfigurator](sheetIndex int, sheetName string, dest *[]T, filterFunc ...func(t T) (add bool)) SheetTarget {
+	return SheetTarget{
+		sheetIndex: sheetIndex,
+		sheetName:  sheetName,
+		read:       targetReadFunc(dest, filterFunc...),
+	}
+}
+
+// TargetPattern builds a SheetTarget that reads the first sheet whose name
+// matches pattern into dest, for use with ReadMulti. Rows matching
+// filterFunc are kept, same as Read.
+func TargetPattern[T ReadConfigurator](pattern *regexp.Regexp, dest *[]T, filterFunc ...func(t T) (add bool)) SheetTarget {
+	return SheetTarget{
+		sheetPattern: pattern,
+		read:         targetReadFunc(dest, filterFunc...),
+	}
+}
+
+// targetReadFunc builds the SheetTarget.read closure shared by Target and TargetPattern.
+func targetReadFunc[T ReadConfigurator](dest *[]T, filterFunc ...func(t T) (add bool)) func(f *xlsx.File, sheetIndex int) error {
+	return func(f *xlsx.File, resolvedIndex int) error {
+		var t T
+		rc := defaultReadConfig()
+		t.ReadConfigure(rc)
+		rc.SheetName = ""
+		rc.SheetIndex = resolvedIndex
+		ts, err := readWithConfig[T](f, rc, filterFunc...)
+		if err != nil {
+			return err
+		}
+		*dest = ts
+		return nil
+	}
+}
+
+// ReadMulti parses reader once and dispatches each SheetTarget to its own
+// sheet, letting a single workbook whose tabs hold different entity types
+// be read in one pass. Targets are resolved and read in the order given.
+// Errors are aggregated per target into a WorkbookError.
+func ReadMulti(reader io.Reader, targets ...SheetTarget) error {
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return readMultiBinary(b, targets...)
+}
+
+// ReadMultiFile is the file-based counterpart of ReadMulti.
+func ReadMultiFile(file string, targets ...SheetTarget) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	return readMultiBinary(b, targets...)
+}
+
+func readMultiBinary(b []byte, targets ...SheetTarget) error {
+	f, err := xlsx.OpenBinary(b)
+	if err != nil {
+		return err
+	}
+
+	var sheetErrors []SheetError
+	for _, target := range targets {
+		sheetIndex := target.sheetIndex
+		sheetName := ""
+		switch {
+		case target.sheetName != "":
+			idx, ok := findSheetByName(f, target.sheetName)
+			if !ok {
+				sheetErrors = append(sheetErrors, SheetError{SheetName: target.sheetName, Err: fmt.Errorf("%w: %q", ErrSheetNotFound, target.sheetName)})
+				continue
+			}
+			sheetIndex = idx
+		case target.sheetPattern != nil:
+			idx, ok := findSheetByPattern(f, target.sheetPattern)
+			if !ok {
+				sheetErrors = append(sheetErrors, SheetError{Err: fmt.Errorf("%w: sheets matching %q", ErrSheetNotFound, target.sheetPattern.String())})
+				continue
+			}
+			sheetIndex = idx
+		}
+		if sheetIndex < 0 || sheetIndex > len(f.Sheet)-1 {
+			sheetErrors = append(sheetErrors, SheetError{SheetIndex: sheetIndex, Err: ErrSheetIndexOutOfRange})
+			continue
+		}
+		sheetName = f.Sheets[sheetIndex].Name
+
+		if err := target.read(f, sheetIndex); err != nil {
+			sheetErrors = append(sheetErrors, SheetError{SheetIndex: sheetIndex, SheetName: sheetName, Err: err})
+		}
+	}
+	if len(sheetErrors) > 0 {
+		return WorkbookError{SheetErrors: sheetErrors}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2022 exl Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exl
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// ErrIndexedTagArrayTooShort is returned by collectLeafBindings when an
+// indexed repeated tag like "Item[1..5]" is applied to a fixed-size array
+// field too short to hold every index in the range. Unlike a slice, an
+// array can't be grown to fit, so this is validated up front rather than
+// left to panic on an out-of-range reflect.Value.Index during the row loop.
+var ErrIndexedTagArrayTooShort = errors.New("exl: indexed tag's range exceeds the destination array's length")
+
+// leafBinding is one flattened, header-addressable destination discovered
+// while walking a struct type: either a plain field, a field reached
+// through nested/embedded structs, or one element of an indexed repeated
+// slice/array field.
+type leafBinding struct {
+	fieldPath     []int
+	elemIndex     int // -1 unless bound to one element of an indexed repeated slice/array
+	elemFieldPath []int
+	header        string
+	hasHeader     bool
+	colIndex      int
+	hasCol        bool
+}
+
+// indexedTagPattern matches a tag like "Item[1..3]": a header prefix
+// followed by an inclusive range of header numbers. The range's first
+// number binds to element 0, the next to element 1, and so on.
+var indexedTagPattern = regexp.MustCompile(`^([^\[\]]+)\[(\d+)\.\.(\d+)\]$`)
+
+// parseIndexedTag parses the tag of an indexed repeated slice/array field.
+// ok is false (with a nil error) if tag does not use the "Prefix[lo..hi]" form.
+func parseIndexedTag(tag string) (prefix string, lo, hi int, ok bool, err error) {
+	m := indexedTagPattern.FindStringSubmatch(tag)
+	if m == nil {
+		return "", 0, 0, false, nil
+	}
+	lo, err = strconv.Atoi(m[2])
+	if err != nil {
+		return "", 0, 0, false, err
+	}
+	hi, err = strconv.Atoi(m[3])
+	if err != nil {
+		return "", 0, 0, false, err
+	}
+	if hi < lo {
+		return "", 0, 0, false, fmt.Errorf("exl: invalid indexed tag %q", tag)
+	}
+	return m[1], lo, hi, true, nil
+}
+
+// isRepeatableContainer reports whether t is a slice or array of structs,
+// i.e. a candidate for an indexed repeated tag like "Item[1..3]".
+func isRepeatableContainer(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Struct
+}
+
+// isSpecialStruct reports struct types handled by a dedicated unmarshal
+// func rather than flattened field-by-field: time.Time, and any type
+// implementing ExcelUnmarshaler or encoding.TextUnmarshaler.
+func isSpecialStruct(t reflect.Type) bool {
+	if t == reflect.TypeOf(time.Time{}) {
+		return true
+	}
+	intf := reflect.New(t).Interface()
+	if _, ok := intf.(ExcelUnmarshaler); ok {
+		return true
+	}
+	if _, ok := intf.(encoding.TextUnmarshaler); ok {
+		return true
+	}
+	return false
+}
+
+// appendPath returns path with i appended, without mutating path's backing array.
+func appendPath(path []int, i int) []int {
+	np := make([]int, len(path)+1)
+	copy(np, path)
+	np[len(path)] = i
+	return np
+}
+
+// collectLeafBindings walks typ's fields, recursing into nested/embedded
+// structs that carry no excel tag of their own, and expanding indexed
+// repeated slice/array fields into one leafBinding per element.
+func collectLeafBindings(typ reflect.Type, tagName string, path []int) ([]leafBinding, error) {
+	var out []leafBinding
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		fieldPath := appendPath(path, i)
+		tag, hasTag := field.Tag.Lookup(tagName)
+		ft := field.Type
+
+		if hasTag && isRepeatableContainer(ft) {
+			if prefix, lo, hi, ok, err := parseIndexedTag(tag); err != nil {
+				return nil, err
+			} else if ok {
+				if ft.Kind() == reflect.Array && ft.Len() < hi-lo+1 {
+					return nil, fmt.Errorf("%w: field %q has tag %q but array length is only %d", ErrIndexedTagArrayTooShort, field.Name, tag, ft.Len())
+				}
+				elemLeaves, err := collectLeafBindings(ft.Elem(), tagName, nil)
+				if err != nil {
+					return nil, err
+				}
+				for elemIndex := 0; elemIndex <= hi-lo; elemIndex++ {
+					for _, sub := range elemLeaves {
+						if !sub.hasHeader {
+							continue
+						}
+						out = append(out, leafBinding{
+							fieldPath:     fieldPath,
+							elemIndex:     elemIndex,
+							elemFieldPath: sub.fieldPath,
+							header:        fmt.Sprintf("%s%d.%s", prefix, lo+elemIndex, sub.header),
+							hasHeader:     true,
+						})
+					}
+				}
+				continue
+			}
+		}
+
+		if !hasTag && ft.Kind() == reflect.Struct && !isSpecialStruct(ft) {
+			sub, err := collectLeafBindings(ft, tagName, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, sub...)
+			continue
+		}
+
+		if !hasTag {
+			continue
+		}
+		tb, err := parseFieldTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, leafBinding{
+			fieldPath: fieldPath,
+			elemIndex: -1,
+			header:    tb.header,
+			hasHeader: tb.hasHeader,
+			colIndex:  tb.colIndex,
+			hasCol:    tb.hasCol,
+		})
+	}
+
+	return out, nil
+}
+
+// dryRunLeafField returns a standalone, addressable zero value of leaf's
+// destination type, for GetUnmarshalFunc dispatch. It never touches the
+// row's actual slice/array containers, so it needs no allocation: every
+// element of a repeated field shares the same static Go type regardless
+// of index.
+func dryRunLeafField(typ reflect.Type, leaf leafBinding) reflect.Value {
+	container := reflect.New(typ).Elem().FieldByIndex(leaf.fieldPath)
+	if leaf.elemIndex < 0 {
+		return container
+	}
+	elem := reflect.New(container.Type().Elem()).Elem()
+	return elem.FieldByIndex(leaf.elemFieldPath)
+}
@@ -0,0 +1,135 @@
+// Copyright 2022 exl Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exl
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseIndexedTag(t *testing.T) {
+	tests := []struct {
+		tag        string
+		wantPrefix string
+		wantLo     int
+		wantHi     int
+		wantOk     bool
+		wantErr    bool
+	}{
+		{tag: "Item[1..3]", wantPrefix: "Item", wantLo: 1, wantHi: 3, wantOk: true},
+		{tag: "Item[1..1]", wantPrefix: "Item", wantLo: 1, wantHi: 1, wantOk: true},
+		{tag: "Name", wantOk: false},
+		{tag: "Item[3..1]", wantErr: true},
+	}
+	for _, tt := range tests {
+		prefix, lo, hi, ok, err := parseIndexedTag(tt.tag)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseIndexedTag(%q): expected error", tt.tag)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseIndexedTag(%q): unexpected error: %v", tt.tag, err)
+			continue
+		}
+		if ok != tt.wantOk {
+			t.Errorf("parseIndexedTag(%q): ok = %v, want %v", tt.tag, ok, tt.wantOk)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if prefix != tt.wantPrefix || lo != tt.wantLo || hi != tt.wantHi {
+			t.Errorf("parseIndexedTag(%q) = (%q, %d, %d), want (%q, %d, %d)",
+				tt.tag, prefix, lo, hi, tt.wantPrefix, tt.wantLo, tt.wantHi)
+		}
+	}
+}
+
+func TestCollectLeafBindings(t *testing.T) {
+	type Item struct {
+		Name string `excel:"Name"`
+	}
+	type Address struct {
+		City string `excel:"City"`
+	}
+	type Plain struct {
+		Name  string `excel:"Name"`
+		Items []Item `excel:"Item[1..3]"`
+	}
+	type Embedded struct {
+		Address
+		Name string `excel:"Name"`
+	}
+	type FixedArrayOK struct {
+		Items [3]Item `excel:"Item[1..3]"`
+	}
+	type FixedArrayTooShort struct {
+		Items [2]Item `excel:"Item[1..3]"`
+	}
+
+	t.Run("plain and indexed slice", func(t *testing.T) {
+		leaves, err := collectLeafBindings(reflect.TypeOf(Plain{}), "excel", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		headers := make([]string, 0, len(leaves))
+		for _, leaf := range leaves {
+			if leaf.hasHeader {
+				headers = append(headers, leaf.header)
+			}
+		}
+		want := []string{"Name", "Item1.Name", "Item2.Name", "Item3.Name"}
+		if !reflect.DeepEqual(headers, want) {
+			t.Errorf("headers = %v, want %v", headers, want)
+		}
+	})
+
+	t.Run("embedded struct is flattened", func(t *testing.T) {
+		leaves, err := collectLeafBindings(reflect.TypeOf(Embedded{}), "excel", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		headers := make([]string, 0, len(leaves))
+		for _, leaf := range leaves {
+			if leaf.hasHeader {
+				headers = append(headers, leaf.header)
+			}
+		}
+		want := []string{"City", "Name"}
+		if !reflect.DeepEqual(headers, want) {
+			t.Errorf("headers = %v, want %v", headers, want)
+		}
+	})
+
+	t.Run("fixed array large enough for the indexed range", func(t *testing.T) {
+		if _, err := collectLeafBindings(reflect.TypeOf(FixedArrayOK{}), "excel", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("fixed array too short for the indexed range errors instead of panicking", func(t *testing.T) {
+		_, err := collectLeafBindings(reflect.TypeOf(FixedArrayTooShort{}), "excel", nil)
+		if !errors.Is(err, ErrIndexedTagArrayTooShort) {
+			t.Fatalf("err = %v, want ErrIndexedTagArrayTooShort", err)
+		}
+	})
+}
+
+func TestIsSpecialStruct(t *testing.T) {
+	type Plain struct{ Name string }
+	if isSpecialStruct(reflect.TypeOf(Plain{})) {
+		t.Errorf("Plain should not be a special struct")
+	}
+}
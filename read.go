@@ -32,6 +32,10 @@ type (
 		// The index of the worksheet to be read.
 		// Defaults to 0, the first worksheet.
 		SheetIndex int
+		// The name of the worksheet to be read, matched case-insensitively.
+		// Takes precedence over SheetIndex when non-empty.
+		// Defaults to "", meaning SheetIndex is used.
+		SheetName string
 		// The row index at which the column headers are read from.
 		// Zero-based, defaults to 0.
 		HeaderRowIndex int
@@ -60,6 +64,20 @@ type (
 		// or caused an error when determining the unmarshaler to use.
 		// Defaults to false.
 		SkipUnknownTypes bool
+		// Restrict reading to a sub-rectangle of the sheet, e.g. "B3:H" or "B3:H500".
+		// When set, it takes precedence over HeaderRowIndex and DataStartRowIndex:
+		// headers are read from the first row of the range, and data starts on
+		// the row after it. The column bounds also apply to header matching,
+		// so columns outside the range are never considered.
+		// Defaults to "", meaning the whole sheet.
+		CellRange string
+		// Controls how cells containing a formula are read.
+		// Defaults to FormulaUseCached.
+		FormulaHandling FormulaHandling
+		// Used to compute a formula cell's value when FormulaHandling is
+		// FormulaEvaluate and tealeg's own cached/formatted value is unavailable.
+		// Required in that case; unused otherwise.
+		FormulaEvaluator FormulaEvaluator
 		// Configure how errors during unmarshaling are handled.
 		// Unmarshaling errors are e.g. invalid number formats in the cell,
 		// date parsing with invalid input,
@@ -78,7 +96,10 @@ type (
 		RowIndex     int // 0-based row index. Printed as 1-based row number in error text.
 		ColumnIndex  int // 0-based column index.
 		ColumnHeader string
-		Err          error
+		// The cell's raw formula string, if reading it failed while it held
+		// a formula (see ReadConfig.FormulaHandling). Empty otherwise.
+		Formula string
+		Err     error
 	}
 	ContentError struct {
 		FieldErrors  []FieldError
@@ -147,20 +168,223 @@ var (
 		}
 	}
 	ErrSheetIndexOutOfRange        = errors.New("exl: sheet index out of range")
+	ErrSheetNotFound               = errors.New("exl: sheet not found")
 	ErrHeaderRowIndexOutOfRange    = errors.New("exl: header row index out of range")
 	ErrDataStartRowIndexOutOfRange = errors.New("exl: data start row index out of range")
 	ErrNoUnmarshaler               = errors.New("no unmarshaler")
 	ErrNoDestinationField          = errors.New("no destination field with matching tag")
 )
 
-func readStrings(maxCol int, row *xlsx.Row) []string {
-	ls := make([]string, maxCol)
-	for i := 0; i < maxCol; i++ {
-		ls[i] = row.GetCell(i).Value
+func readStrings(startCol, width int, row *xlsx.Row) []string {
+	ls := make([]string, width)
+	for i := 0; i < width; i++ {
+		ls[i] = row.GetCell(startCol + i).Value
 	}
 	return ls
 }
 
+// fieldInfo records, for a single worksheet column, which struct field it
+// binds to and which function unmarshals its cells. unmarshalFunc is nil
+// for columns that are skipped (no destination field, or unsupported type).
+//
+// fieldPath addresses the destination via reflect.Value.FieldByIndex, so it
+// reaches into nested/embedded structs. elemIndex is -1 unless the column is
+// bound to one element of an indexed repeated slice/array field (see
+// parseIndexedTag), in which case fieldPath addresses the slice/array field
+// itself and elemFieldPath addresses the leaf field within its element type.
+type fieldInfo struct {
+	fieldPath     []int
+	elemIndex     int
+	elemFieldPath []int
+	header        string
+	unmarshalFunc UnmarshalExcelFunc
+}
+
+// destField resolves the settable reflect.Value this column unmarshals into.
+func (fi fieldInfo) destField(val reflect.Value) reflect.Value {
+	container := val.FieldByIndex(fi.fieldPath)
+	if fi.elemIndex < 0 {
+		return container
+	}
+	return container.Index(fi.elemIndex).FieldByIndex(fi.elemFieldPath)
+}
+
+// sliceAlloc records that the slice field at fieldPath must be grown to
+// length before any of its elements are populated.
+type sliceAlloc struct {
+	fieldPath []int
+	length    int
+}
+
+// applySliceAllocs grows every slice field referenced by allocs to its
+// required length, so fieldInfo.destField can index into it.
+func applySliceAllocs(val reflect.Value, allocs []sliceAlloc) {
+	for _, a := range allocs {
+		field := val.FieldByIndex(a.fieldPath)
+		if field.Kind() == reflect.Slice && field.Len() < a.length {
+			field.Set(reflect.MakeSlice(field.Type(), a.length, a.length))
+		}
+	}
+}
+
+// buildColumnFields resolves, for each header, the destination struct field
+// and unmarshaler to use, honoring rc.SkipUnknownColumns/SkipUnknownTypes.
+// It is shared by every reading entry point so header/tag resolution stays
+// in one place regardless of how rows are subsequently iterated.
+//
+// Nested/embedded structs are flattened recursively (collectLeafBindings),
+// and indexed repeated slice/array fields contribute one leaf per element,
+// so wide "columnar-repeated" spreadsheets can bind into a natural nested
+// Go shape. The returned sliceAllocs must be applied to each row value
+// before its fields are populated.
+//
+// colOffset is resolvedRange.colOffset: a column-letter tag (e.g. "$C") is
+// always written relative to the sheet, but headers (and the columnIndex a
+// caller's row loop indexes cells with) are relative to the read range, so
+// colOffset is subtracted before matching a tag's column index against them.
+func buildColumnFields(typ reflect.Type, rc *ReadConfig, headers []string, colOffset int) ([]fieldInfo, []sliceAlloc, error) {
+	leaves, err := collectLeafBindings(typ, rc.TagName, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Key: Header / Tag name
+	// Value: matching leaf binding
+	tagToLeafMap := make(map[string]leafBinding, 0)
+	// Key: Column Index (relative to the read range)
+	// Value: matching leaf binding
+	colTagLeafMap := make(map[int]leafBinding, 0)
+	for _, leaf := range leaves {
+		if leaf.hasCol {
+			// A column-letter binding wins and short-circuits the header
+			// lookup for this field, even if a header name is also set.
+			// leaf.colIndex is a sheet-absolute column; rebase it onto the
+			// read range before using it as a columnIndex lookup key.
+			colTagLeafMap[leaf.colIndex-colOffset] = leaf
+		} else if leaf.hasHeader {
+			tagToLeafMap[leaf.header] = leaf
+		}
+	}
+
+	sliceLens := make(map[string]int, 0)
+	columnFields := make([]fieldInfo, len(headers))
+
+	for columnIndex, header := range headers {
+		leaf, have := colTagLeafMap[columnIndex]
+		if !have {
+			leaf, have = tagToLeafMap[header]
+		}
+		if !have {
+			if rc.SkipUnknownColumns {
+				// Skip reading this field
+				columnFields[columnIndex] = fieldInfo{elemIndex: -1, header: header}
+				continue
+			} else {
+				return nil, nil, fmt.Errorf("%w for column \"%s\" at index %d", ErrNoDestinationField, header, columnIndex)
+			}
+		}
+
+		field := dryRunLeafField(typ, leaf)
+
+		unmarshaler := GetUnmarshalFunc(field)
+		if unmarshaler == nil {
+			if rc.SkipUnknownTypes {
+				// Skip reading this field
+				columnFields[columnIndex] = fieldInfo{
+					fieldPath:     leaf.fieldPath,
+					elemIndex:     leaf.elemIndex,
+					elemFieldPath: leaf.elemFieldPath,
+					header:        header,
+					unmarshalFunc: nil,
+				}
+				continue
+			} else {
+				return nil, nil, fmt.Errorf("%w for column \"%s\" at index %d", ErrNoUnmarshaler, header, columnIndex)
+			}
+		}
+
+		if leaf.elemIndex >= 0 {
+			key := fmt.Sprint(leaf.fieldPath)
+			if leaf.elemIndex+1 > sliceLens[key] {
+				sliceLens[key] = leaf.elemIndex + 1
+			}
+		}
+
+		columnFields[columnIndex] = fieldInfo{
+			fieldPath:     leaf.fieldPath,
+			elemIndex:     leaf.elemIndex,
+			elemFieldPath: leaf.elemFieldPath,
+			header:        header,
+			unmarshalFunc: unmarshaler,
+		}
+	}
+
+	sliceAllocs := make([]sliceAlloc, 0, len(sliceLens))
+	for _, leaf := range leaves {
+		if leaf.elemIndex != 0 {
+			continue
+		}
+		key := fmt.Sprint(leaf.fieldPath)
+		if length, have := sliceLens[key]; have {
+			sliceAllocs = append(sliceAllocs, sliceAlloc{fieldPath: leaf.fieldPath, length: length})
+			delete(sliceLens, key)
+		}
+	}
+
+	return columnFields, sliceAllocs, nil
+}
+
+// resolvedRange carries the effective row/column bounds to read from,
+// after resolving rc.HeaderRowIndex/DataStartRowIndex and any ReadConfig.CellRange.
+type resolvedRange struct {
+	headerRowIndex    int
+	dataStartRowIndex int
+	colOffset         int
+	lastRowIndex      int
+}
+
+// resolveSheetAndHeaders validates rc against the opened workbook and
+// returns the configured sheet, its header row values, and the row/column
+// bounds to read data from.
+func resolveSheetAndHeaders(f *xlsx.File, rc *ReadConfig) (*xlsx.Sheet, []string, resolvedRange, error) {
+	if rc.SheetIndex < 0 || rc.SheetIndex > len(f.Sheet)-1 {
+		return nil, nil, resolvedRange{}, ErrSheetIndexOutOfRange
+	}
+	sheet := f.Sheets[rc.SheetIndex]
+
+	rr := resolvedRange{
+		headerRowIndex:    rc.HeaderRowIndex,
+		dataStartRowIndex: rc.DataStartRowIndex,
+		lastRowIndex:      sheet.MaxRow - 1,
+	}
+	lastColIndex := sheet.MaxCol - 1
+
+	if rc.CellRange != "" {
+		cr, err := parseCellRange(rc.CellRange)
+		if err != nil {
+			return nil, nil, resolvedRange{}, err
+		}
+		rr.headerRowIndex = cr.startRow
+		rr.dataStartRowIndex = cr.startRow + 1
+		rr.colOffset = cr.startCol
+		lastColIndex = cr.endCol
+		if cr.endRow >= 0 {
+			rr.lastRowIndex = cr.endRow
+		}
+	}
+
+	if rr.headerRowIndex < 0 || rr.headerRowIndex > sheet.MaxRow-1 {
+		return nil, nil, resolvedRange{}, ErrHeaderRowIndexOutOfRange
+	}
+	if rr.dataStartRowIndex < 0 || rr.dataStartRowIndex > sheet.MaxRow-1 {
+		return nil, nil, resolvedRange{}, ErrDataStartRowIndexOutOfRange
+	}
+
+	headerRow, _ := sheet.Row(rr.headerRowIndex)
+	headers := readStrings(rr.colOffset, lastColIndex-rr.colOffset+1, headerRow)
+	return sheet, headers, rr, nil
+}
+
 func GetUnmarshalFunc(destField reflect.Value) UnmarshalExcelFunc {
 	if destField.CanInterface() {
 
@@ -225,82 +449,29 @@ func ReadBinary[T ReadConfigurator](bytes []byte, filterFunc ...func(t T) (add b
 	var t T
 	rc := defaultReadConfig()
 	t.ReadConfigure(rc)
-	if rc.SheetIndex < 0 || rc.SheetIndex > len(f.Sheet)-1 {
-		return nil, ErrSheetIndexOutOfRange
-	}
-	sheet := f.Sheets[rc.SheetIndex]
-	if rc.HeaderRowIndex < 0 || rc.HeaderRowIndex > sheet.MaxRow-1 {
-		return nil, ErrHeaderRowIndexOutOfRange
-	}
-	if rc.DataStartRowIndex < 0 || rc.DataStartRowIndex > sheet.MaxRow-1 {
-		return nil, ErrDataStartRowIndexOutOfRange
-	}
-	headerRow, _ := sheet.Row(rc.HeaderRowIndex)
-	maxCol := sheet.MaxCol
-	headers := readStrings(maxCol, headerRow)
-	type fieldInfo struct {
-		reflectFieldIndex int
-		header            string
-		unmarshalFunc     UnmarshalExcelFunc
-	}
-	// Key: Header / Tag name
-	// Value: Reflection field index
-	tagToFieldMap := make(map[string]int, 0)
-	// Key: Column Index
-	// Value: Unmarshaling Info
-	columnFields := make([]fieldInfo, len(headers))
+	return readWithConfig[T](f, rc, filterFunc...)
+}
 
-	typ := reflect.TypeOf(t).Elem()
-	for i := 0; i < typ.NumField(); i++ {
-		if ta := typ.Field(i).Tag; ta != "" {
-			if tt, have := ta.Lookup(rc.TagName); have {
-				tagToFieldMap[tt] = i
-			}
+// readWithConfig reads an already-opened workbook according to rc, sharing
+// the row loop used by ReadBinary. It backs ReadBinary directly, and backs
+// ReadAll/ReadMulti for reading a specific sheet of a workbook opened once.
+func readWithConfig[T ReadConfigurator](f *xlsx.File, rc *ReadConfig, filterFunc ...func(t T) (add bool)) ([]T, error) {
+	if rc.SheetName != "" {
+		sheetIndex, ok := findSheetByName(f, rc.SheetName)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrSheetNotFound, rc.SheetName)
 		}
+		rc.SheetIndex = sheetIndex
 	}
-
-	{
-		val := reflect.New(typ).Elem()
-
-		for columnIndex, header := range headers {
-			reflectFieldIndex, have := tagToFieldMap[header]
-			if !have {
-				if rc.SkipUnknownColumns {
-					// Skip reading this field
-					columnFields[columnIndex] = fieldInfo{
-						reflectFieldIndex: reflectFieldIndex,
-						header:            header,
-						unmarshalFunc:     nil,
-					}
-					continue
-				} else {
-					return nil, fmt.Errorf("%w for column \"%s\" at index %d", ErrNoDestinationField, header, columnIndex)
-				}
-			}
-
-			field := val.Field(reflectFieldIndex)
-
-			unmarshaler := GetUnmarshalFunc(field)
-			if unmarshaler == nil {
-				if rc.SkipUnknownTypes {
-					// Skip reading this field
-					columnFields[columnIndex] = fieldInfo{
-						reflectFieldIndex: reflectFieldIndex,
-						header:            header,
-						unmarshalFunc:     nil,
-					}
-					continue
-				} else {
-					return nil, fmt.Errorf("%w for column \"%s\" at index %d", ErrNoUnmarshaler, header, columnIndex)
-				}
-			}
-
-			columnFields[columnIndex] = fieldInfo{
-				reflectFieldIndex: reflectFieldIndex,
-				header:            header,
-				unmarshalFunc:     unmarshaler,
-			}
-		}
+	sheet, headers, rr, err := resolveSheetAndHeaders(f, rc)
+	if err != nil {
+		return nil, err
+	}
+	var t T
+	typ := reflect.TypeOf(t).Elem()
+	columnFields, sliceAllocs, err := buildColumnFields(typ, rc, headers, rr.colOffset)
+	if err != nil {
+		return nil, err
 	}
 
 	unmarshalConfig := &ExcelUnmarshalParameters{
@@ -312,57 +483,60 @@ func ReadBinary[T ReadConfigurator](bytes []byte, filterFunc ...func(t T) (add b
 	collectedErrors := make([]FieldError, 0)
 
 	ts := make([]T, 0)
-	for rowIndex := 0; rowIndex < sheet.MaxRow; rowIndex++ {
-		if rowIndex >= rc.DataStartRowIndex {
-			val := reflect.New(typ).Elem()
-			if row, _ := sheet.Row(rowIndex); row != nil {
-
-				for columnIndex, fi := range columnFields {
-					// If there is no unmarshal function,
-					// this field has been skipped by previous logic.
-					// e.g. no destination field, or unknown type.
-					if fi.unmarshalFunc == nil {
-						continue
-					}
-					cell := row.GetCell(columnIndex)
+	for rowIndex := rr.dataStartRowIndex; rowIndex <= rr.lastRowIndex; rowIndex++ {
+		val := reflect.New(typ).Elem()
+		if row, _ := sheet.Row(rowIndex); row != nil {
+			applySliceAllocs(val, sliceAllocs)
+
+			for columnIndex, fi := range columnFields {
+				// If there is no unmarshal function,
+				// this field has been skipped by previous logic.
+				// e.g. no destination field, or unknown type.
+				if fi.unmarshalFunc == nil {
+					continue
+				}
+				cell := row.GetCell(rr.colOffset + columnIndex)
+				cell, formula, err := resolveFormulaCell(cell, sheet.Name, rowIndex, rr.colOffset+columnIndex, rc)
 
-					destField := val.Field(fi.reflectFieldIndex)
+				destField := fi.destField(val)
+				if err == nil {
 					err = fi.unmarshalFunc(destField, cell, unmarshalConfig)
-					if err != nil && rc.UnmarshalErrorHandling != UnmarshalErrorIgnore {
-						ferr := FieldError{
-							RowIndex:     rowIndex,
-							ColumnIndex:  columnIndex,
-							ColumnHeader: fi.header,
-							Err:          err,
-						}
-						if rc.UnmarshalErrorHandling == UnmarshalErrorAbort {
-							return nil, ferr
-						} else {
-							collectedErrors = append(collectedErrors, ferr)
-							if rc.MaxUnmarshalErrors > 0 && uint64(len(collectedErrors)) >= rc.MaxUnmarshalErrors {
-								return nil, ContentError{
-									FieldErrors:  collectedErrors,
-									LimitReached: true,
-								}
+				}
+				if err != nil && rc.UnmarshalErrorHandling != UnmarshalErrorIgnore {
+					ferr := FieldError{
+						RowIndex:     rowIndex,
+						ColumnIndex:  rr.colOffset + columnIndex,
+						ColumnHeader: fi.header,
+						Formula:      formula,
+						Err:          err,
+					}
+					if rc.UnmarshalErrorHandling == UnmarshalErrorAbort {
+						return nil, ferr
+					} else {
+						collectedErrors = append(collectedErrors, ferr)
+						if rc.MaxUnmarshalErrors > 0 && uint64(len(collectedErrors)) >= rc.MaxUnmarshalErrors {
+							return nil, ContentError{
+								FieldErrors:  collectedErrors,
+								LimitReached: true,
 							}
 						}
 					}
 				}
-				nT := val.Addr().Interface().(T)
-				add := true
-				if filterFunc != nil && len(filterFunc) > 0 {
-					for _, fF := range filterFunc {
-						if fF != nil {
-							add = fF(nT)
-							if !add {
-								break
-							}
+			}
+			nT := val.Addr().Interface().(T)
+			add := true
+			if filterFunc != nil && len(filterFunc) > 0 {
+				for _, fF := range filterFunc {
+					if fF != nil {
+						add = fF(nT)
+						if !add {
+							break
 						}
 					}
 				}
-				if add {
-					ts = append(ts, nT)
-				}
+			}
+			if add {
+				ts = append(ts, nT)
 			}
 		}
 	}
@@ -0,0 +1,141 @@
+// Copyright 2022 exl Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exl
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/tealeg/xlsx/v3"
+)
+
+// ReadStream reads reader row by row, invoking yield for each data row
+// instead of accumulating the results into a slice.
+// Unlike Read/ReadFile/ReadBinary, no []T of results is built: each row's
+// T is handed to yield and not retained. The workbook itself is still read
+// and parsed in full before the first row is yielded.
+// Return false from yield to stop reading early; ReadStream then returns nil.
+// rc.UnmarshalErrorHandling still governs per-field errors: UnmarshalErrorAbort
+// stops reading and returns the error, UnmarshalErrorIgnore drops it (yield
+// gets a nil err), and UnmarshalErrorCollect passes the first error for the
+// row to yield as its err argument and keeps reading.
+// Under UnmarshalErrorCollect, rc.MaxUnmarshalErrors still applies: once that
+// many field errors have been seen across the whole stream, the row that
+// tripped the limit is yielded and ReadStream returns a ContentError, the
+// same as the batch reading functions.
+func ReadStream[T ReadConfigurator](reader io.Reader, yield func(rowIndex int, t T, err error) bool) error {
+	bytes, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return ReadStreamBinary(bytes, yield)
+}
+
+// ReadFileStream is the streaming counterpart of ReadFile.
+func ReadFileStream[T ReadConfigurator](file string, yield func(rowIndex int, t T, err error) bool) error {
+	bytes, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	return ReadStreamBinary(bytes, yield)
+}
+
+// ReadStreamBinary is the streaming counterpart of ReadBinary.
+// It resolves headers and the tag-to-field mapping exactly as ReadBinary
+// does, then walks the sheet row by row via xlsx.Sheet.Row instead of
+// collecting every row into a []T.
+func ReadStreamBinary[T ReadConfigurator](bytes []byte, yield func(rowIndex int, t T, err error) bool) error {
+	f, err := xlsx.OpenBinary(bytes)
+	if err != nil {
+		return err
+	}
+	var t T
+	rc := defaultReadConfig()
+	t.ReadConfigure(rc)
+	if rc.SheetName != "" {
+		sheetIndex, ok := findSheetByName(f, rc.SheetName)
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrSheetNotFound, rc.SheetName)
+		}
+		rc.SheetIndex = sheetIndex
+	}
+	sheet, headers, rr, err := resolveSheetAndHeaders(f, rc)
+	if err != nil {
+		return err
+	}
+	typ := reflect.TypeOf(t).Elem()
+	columnFields, sliceAllocs, err := buildColumnFields(typ, rc, headers, rr.colOffset)
+	if err != nil {
+		return err
+	}
+
+	unmarshalConfig := &ExcelUnmarshalParameters{
+		TrimSpace:           rc.TrimSpace,
+		Date1904:            f.Date1904,
+		FallbackDateFormats: rc.FallbackDateFormats,
+	}
+
+	collectedErrors := make([]FieldError, 0)
+
+	for rowIndex := rr.dataStartRowIndex; rowIndex <= rr.lastRowIndex; rowIndex++ {
+		row, _ := sheet.Row(rowIndex)
+		if row == nil {
+			continue
+		}
+
+		val := reflect.New(typ).Elem()
+		applySliceAllocs(val, sliceAllocs)
+		var rowErr error
+		for columnIndex, fi := range columnFields {
+			if fi.unmarshalFunc == nil {
+				continue
+			}
+			cell := row.GetCell(rr.colOffset + columnIndex)
+			cell, formula, uErr := resolveFormulaCell(cell, sheet.Name, rowIndex, rr.colOffset+columnIndex, rc)
+
+			destField := fi.destField(val)
+			if uErr == nil {
+				uErr = fi.unmarshalFunc(destField, cell, unmarshalConfig)
+			}
+			if uErr != nil && rc.UnmarshalErrorHandling != UnmarshalErrorIgnore {
+				ferr := FieldError{
+					RowIndex:     rowIndex,
+					ColumnIndex:  rr.colOffset + columnIndex,
+					ColumnHeader: fi.header,
+					Formula:      formula,
+					Err:          uErr,
+				}
+				if rc.UnmarshalErrorHandling == UnmarshalErrorAbort {
+					return ferr
+				}
+				if rowErr == nil {
+					rowErr = ferr
+				}
+				collectedErrors = append(collectedErrors, ferr)
+			}
+		}
+
+		nT := val.Addr().Interface().(T)
+		if !yield(rowIndex, nT, rowErr) {
+			return nil
+		}
+		if rc.UnmarshalErrorHandling == UnmarshalErrorCollect && rc.MaxUnmarshalErrors > 0 && uint64(len(collectedErrors)) >= rc.MaxUnmarshalErrors {
+			return ContentError{
+				FieldErrors:  collectedErrors,
+				LimitReached: true,
+			}
+		}
+	}
+	return nil
+}
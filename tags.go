@@ -0,0 +1,134 @@
+// Copyright 2022 exl Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// tagBinding is the parsed form of a single field's excel tag.
+// A tag is either a plain header name ("Name"), a column-letter binding
+// ("$C" or "col=C"), or a header name with a column-letter option
+// ("Name,col=C"). When a column is set, it is what the field binds to and
+// the header name is not looked up (see buildColumnFields).
+type tagBinding struct {
+	header    string
+	hasHeader bool
+	colIndex  int
+	hasCol    bool
+}
+
+// parseFieldTag parses the raw excel tag value of a struct field.
+func parseFieldTag(tag string) (tagBinding, error) {
+	var tb tagBinding
+	parts := strings.Split(tag, ",")
+
+	first := strings.TrimSpace(parts[0])
+	switch {
+	case strings.HasPrefix(first, "$"):
+		idx, err := columnLettersToIndex(first[1:])
+		if err != nil {
+			return tagBinding{}, err
+		}
+		tb.colIndex, tb.hasCol = idx, true
+	case strings.HasPrefix(first, "col="):
+		idx, err := columnLettersToIndex(strings.TrimPrefix(first, "col="))
+		if err != nil {
+			return tagBinding{}, err
+		}
+		tb.colIndex, tb.hasCol = idx, true
+	case first != "":
+		tb.header, tb.hasHeader = first, true
+	}
+
+	for _, opt := range parts[1:] {
+		opt = strings.TrimSpace(opt)
+		if strings.HasPrefix(opt, "col=") {
+			idx, err := columnLettersToIndex(strings.TrimPrefix(opt, "col="))
+			if err != nil {
+				return tagBinding{}, err
+			}
+			tb.colIndex, tb.hasCol = idx, true
+		}
+	}
+
+	return tb, nil
+}
+
+// columnLettersToIndex converts spreadsheet column letters ("A", "B", ...,
+// "AA", ...) to a 0-based column index.
+func columnLettersToIndex(letters string) (int, error) {
+	letters = strings.ToUpper(strings.TrimSpace(letters))
+	if letters == "" {
+		return 0, fmt.Errorf("exl: empty column letters")
+	}
+	index := 0
+	for _, r := range letters {
+		if r < 'A' || r > 'Z' {
+			return 0, fmt.Errorf("exl: invalid column letters %q", letters)
+		}
+		index = index*26 + int(r-'A') + 1
+	}
+	return index - 1, nil
+}
+
+// cellRange is the parsed form of ReadConfig.CellRange.
+// endRow is -1 when the range left the closing row open ("B3:H"),
+// meaning it extends to the last row of the sheet.
+type cellRange struct {
+	startCol, startRow int
+	endCol, endRow     int
+}
+
+var cellRangePattern = regexp.MustCompile(`(?i)^([A-Z]+)(\d+):([A-Z]+)(\d*)$`)
+
+// parseCellRange parses a range like "B3:H" or "B3:H500" into 0-based
+// column/row bounds. The header row is the range's first row; data starts
+// on the row after it.
+func parseCellRange(s string) (cellRange, error) {
+	m := cellRangePattern.FindStringSubmatch(s)
+	if m == nil {
+		return cellRange{}, fmt.Errorf("exl: invalid cell range %q", s)
+	}
+
+	startCol, err := columnLettersToIndex(m[1])
+	if err != nil {
+		return cellRange{}, err
+	}
+	startRow, err := strconv.Atoi(m[2])
+	if err != nil {
+		return cellRange{}, err
+	}
+	endCol, err := columnLettersToIndex(m[3])
+	if err != nil {
+		return cellRange{}, err
+	}
+
+	endRow := -1
+	if m[4] != "" {
+		endRow, err = strconv.Atoi(m[4])
+		if err != nil {
+			return cellRange{}, err
+		}
+		endRow--
+	}
+
+	return cellRange{
+		startCol: startCol,
+		startRow: startRow - 1,
+		endCol:   endCol,
+		endRow:   endRow,
+	}, nil
+}
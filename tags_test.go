@@ -0,0 +1,116 @@
+// Copyright 2022 exl Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exl
+
+import "testing"
+
+func TestColumnLettersToIndex(t *testing.T) {
+	tests := []struct {
+		letters string
+		want    int
+		wantErr bool
+	}{
+		{letters: "A", want: 0},
+		{letters: "B", want: 1},
+		{letters: "Z", want: 25},
+		{letters: "AA", want: 26},
+		{letters: "AZ", want: 51},
+		{letters: "a", want: 0},
+		{letters: " C ", want: 2},
+		{letters: "", wantErr: true},
+		{letters: "1", wantErr: true},
+		{letters: "A1", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := columnLettersToIndex(tt.letters)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("columnLettersToIndex(%q): expected error, got index %d", tt.letters, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("columnLettersToIndex(%q): unexpected error: %v", tt.letters, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("columnLettersToIndex(%q) = %d, want %d", tt.letters, got, tt.want)
+		}
+	}
+}
+
+func TestParseFieldTag(t *testing.T) {
+	tests := []struct {
+		tag     string
+		want    tagBinding
+		wantErr bool
+	}{
+		{tag: "Name", want: tagBinding{header: "Name", hasHeader: true}},
+		{tag: "$C", want: tagBinding{colIndex: 2, hasCol: true}},
+		{tag: "col=C", want: tagBinding{colIndex: 2, hasCol: true}},
+		{tag: "col=B", want: tagBinding{colIndex: 1, hasCol: true}},
+		{tag: "Name,col=C", want: tagBinding{header: "Name", hasHeader: true, colIndex: 2, hasCol: true}},
+		{tag: "$C,col=E", want: tagBinding{colIndex: 4, hasCol: true}},
+		{tag: "", want: tagBinding{}},
+		{tag: "$", wantErr: true},
+		{tag: "col=", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseFieldTag(tt.tag)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseFieldTag(%q): expected error, got %+v", tt.tag, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseFieldTag(%q): unexpected error: %v", tt.tag, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseFieldTag(%q) = %+v, want %+v", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestParseCellRange(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    cellRange
+		wantErr bool
+	}{
+		{s: "B3:H500", want: cellRange{startCol: 1, startRow: 2, endCol: 7, endRow: 499}},
+		{s: "B3:H", want: cellRange{startCol: 1, startRow: 2, endCol: 7, endRow: -1}},
+		{s: "A1:A1", want: cellRange{startCol: 0, startRow: 0, endCol: 0, endRow: 0}},
+		{s: "b3:h500", want: cellRange{startCol: 1, startRow: 2, endCol: 7, endRow: 499}},
+		{s: "", wantErr: true},
+		{s: "B3", wantErr: true},
+		{s: "3:H500", wantErr: true},
+		{s: "B0:H500", want: cellRange{startCol: 1, startRow: -1, endCol: 7, endRow: 499}},
+	}
+	for _, tt := range tests {
+		got, err := parseCellRange(tt.s)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseCellRange(%q): expected error, got %+v", tt.s, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseCellRange(%q): unexpected error: %v", tt.s, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseCellRange(%q) = %+v, want %+v", tt.s, got, tt.want)
+		}
+	}
+}
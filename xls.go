@@ -0,0 +1,247 @@
+// Copyright 2022 exl Author. All Rights Reserved.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//      http://www.apache.org/licenses/LICENSE-2.0
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exl
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/extrame/xls"
+	"github.com/tealeg/xlsx/v3"
+)
+
+var (
+	xlsxMagic = []byte{0x50, 0x4B, 0x03, 0x04} // "PK\x03\x04", a zip local file header
+	xlsMagic  = []byte{0xD0, 0xCF, 0x11, 0xE0} // OLE2 compound file header
+)
+
+// ErrUnknownFormat is returned by ReadAuto when the leading bytes of the
+// input match neither a zip (.xlsx) nor an OLE2 (.xls) container.
+var ErrUnknownFormat = errors.New("exl: unrecognized workbook format")
+
+// ErrCellRangeUnsupportedForXLS is returned by ReadXLSBinary when
+// ReadConfig.CellRange is set. Legacy BIFF worksheets are read through
+// extrame/xls, which walks whole rows rather than the column-bounded
+// sub-rectangle resolveSheetAndHeaders parses for the .xlsx path, so
+// CellRange has no effect here and is rejected rather than silently ignored.
+var ErrCellRangeUnsupportedForXLS = errors.New("exl: ReadConfig.CellRange is not supported for legacy .xls workbooks")
+
+// sniffFormat inspects the leading bytes of an Excel file and reports
+// whether it looks like an OOXML (.xlsx) or legacy BIFF (.xls) workbook.
+// It returns "" if neither magic number matches.
+func sniffFormat(b []byte) string {
+	switch {
+	case bytes.HasPrefix(b, xlsxMagic):
+		return "xlsx"
+	case bytes.HasPrefix(b, xlsMagic):
+		return "xls"
+	default:
+		return ""
+	}
+}
+
+// ReadXLS reads reader as a legacy BIFF (.xls) workbook, each row bind to `T`.
+// It otherwise behaves like Read, except as noted on ReadXLSBinary.
+func ReadXLS[T ReadConfigurator](reader io.Reader, filterFunc ...func(t T) (add bool)) ([]T, error) {
+	if bytes, err := io.ReadAll(reader); err != nil {
+		return []T(nil), err
+	} else {
+		return ReadXLSBinary(bytes, filterFunc...)
+	}
+}
+
+// ReadXLSFile reads file as a legacy BIFF (.xls) workbook, each row bind to `T`.
+func ReadXLSFile[T ReadConfigurator](file string, filterFunc ...func(t T) (add bool)) ([]T, error) {
+	if bytes, err := os.ReadFile(file); err != nil {
+		return []T(nil), err
+	} else {
+		return ReadXLSBinary(bytes, filterFunc...)
+	}
+}
+
+// ReadXLSBinary is the legacy BIFF (.xls) counterpart of ReadBinary.
+// It reuses buildColumnFields for header/tag resolution, so ReadConfig,
+// GetUnmarshalFunc, FieldError and ContentError all behave identically to
+// the .xlsx path; only the sheet/row/cell source is swapped out.
+// Cell values read from the BIFF stream are wrapped in an xlsx.Cell so the
+// same UnmarshalExcelFunc implementations apply unchanged.
+// ReadConfig.SheetName is honored the same way as for the .xlsx path.
+// ReadConfig.CellRange is not supported here and reported via
+// ErrCellRangeUnsupportedForXLS rather than silently ignored.
+// ReadConfig.FormulaHandling and FormulaEvaluator are not applied: each
+// cell is read as the string row.Col returns, and FieldError.Formula is
+// always empty.
+func ReadXLSBinary[T ReadConfigurator](b []byte, filterFunc ...func(t T) (add bool)) ([]T, error) {
+	wb, err := xls.OpenReader(bytes.NewReader(b), "utf-8")
+	if err != nil {
+		return nil, err
+	}
+	var t T
+	rc := defaultReadConfig()
+	t.ReadConfigure(rc)
+	if rc.CellRange != "" {
+		return nil, ErrCellRangeUnsupportedForXLS
+	}
+	if rc.SheetName != "" {
+		sheetIndex, ok := findXLSSheetByName(wb, rc.SheetName)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrSheetNotFound, rc.SheetName)
+		}
+		rc.SheetIndex = sheetIndex
+	}
+	if rc.SheetIndex < 0 || rc.SheetIndex > wb.NumSheets()-1 {
+		return nil, ErrSheetIndexOutOfRange
+	}
+	sheet := wb.GetSheet(rc.SheetIndex)
+	if rc.HeaderRowIndex < 0 || uint16(rc.HeaderRowIndex) > sheet.MaxRow {
+		return nil, ErrHeaderRowIndexOutOfRange
+	}
+	if rc.DataStartRowIndex < 0 || uint16(rc.DataStartRowIndex) > sheet.MaxRow {
+		return nil, ErrDataStartRowIndexOutOfRange
+	}
+
+	headerRow := sheet.Row(rc.HeaderRowIndex)
+	headers := xlsRowStrings(headerRow)
+
+	typ := reflect.TypeOf(t).Elem()
+	columnFields, sliceAllocs, err := buildColumnFields(typ, rc, headers, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	unmarshalConfig := &ExcelUnmarshalParameters{
+		TrimSpace: rc.TrimSpace,
+		// extrame/xls parses the workbook's DATEMODE record into an
+		// unexported field and never exposes it: there is no accessor to
+		// read BIFF's equivalent of OOXML's Date1904 flag from this
+		// package. This is always false as a result, which is correct for
+		// the overwhelming majority of .xls files (the 1900 date system);
+		// only pre-2011 Mac Excel files using the rare 1904 system would
+		// misread raw serial-number date cells. Cells with a recognized
+		// date number format are unaffected either way, since extrame/xls
+		// pre-formats those to RFC 3339 strings internally using its own
+		// (correct) date mode before Col ever returns them to us.
+		Date1904:            false,
+		FallbackDateFormats: rc.FallbackDateFormats,
+	}
+
+	collectedErrors := make([]FieldError, 0)
+
+	ts := make([]T, 0)
+	for rowIndex := rc.DataStartRowIndex; rowIndex <= int(sheet.MaxRow); rowIndex++ {
+		row := sheet.Row(rowIndex)
+		if row == nil {
+			continue
+		}
+
+		val := reflect.New(typ).Elem()
+		applySliceAllocs(val, sliceAllocs)
+		for columnIndex, fi := range columnFields {
+			if fi.unmarshalFunc == nil {
+				continue
+			}
+			cell := &xlsx.Cell{Value: row.Col(columnIndex)}
+
+			destField := fi.destField(val)
+			err = fi.unmarshalFunc(destField, cell, unmarshalConfig)
+			if err != nil && rc.UnmarshalErrorHandling != UnmarshalErrorIgnore {
+				ferr := FieldError{
+					RowIndex:     rowIndex,
+					ColumnIndex:  columnIndex,
+					ColumnHeader: fi.header,
+					Err:          err,
+				}
+				if rc.UnmarshalErrorHandling == UnmarshalErrorAbort {
+					return nil, ferr
+				} else {
+					collectedErrors = append(collectedErrors, ferr)
+					if rc.MaxUnmarshalErrors > 0 && uint64(len(collectedErrors)) >= rc.MaxUnmarshalErrors {
+						return nil, ContentError{
+							FieldErrors:  collectedErrors,
+							LimitReached: true,
+						}
+					}
+				}
+			}
+		}
+
+		nT := val.Addr().Interface().(T)
+		add := true
+		if filterFunc != nil && len(filterFunc) > 0 {
+			for _, fF := range filterFunc {
+				if fF != nil {
+					add = fF(nT)
+					if !add {
+						break
+					}
+				}
+			}
+		}
+		if add {
+			ts = append(ts, nT)
+		}
+	}
+	if len(collectedErrors) > 0 {
+		return nil, ContentError{
+			FieldErrors:  collectedErrors,
+			LimitReached: false,
+		}
+	}
+	return ts, nil
+}
+
+// findXLSSheetByName looks up a sheet by name, case-insensitively, mirroring
+// findSheetByName for the .xlsx path.
+func findXLSSheetByName(wb *xls.WorkBook, name string) (int, bool) {
+	for i := 0; i < wb.NumSheets(); i++ {
+		if strings.EqualFold(wb.GetSheet(i).Name, name) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// xlsRowStrings reads every column of a legacy BIFF row as a string,
+// mirroring readStrings for the .xlsx path.
+func xlsRowStrings(row *xls.Row) []string {
+	if row == nil {
+		return nil
+	}
+	ls := make([]string, row.LastCol())
+	for i := range ls {
+		ls[i] = row.Col(i)
+	}
+	return ls
+}
+
+// ReadAuto sniffs the leading bytes of reader to tell an OOXML (.xlsx)
+// workbook apart from a legacy BIFF (.xls) one, then dispatches to
+// ReadBinary or ReadXLSBinary accordingly.
+func ReadAuto[T ReadConfigurator](reader io.Reader, filterFunc ...func(t T) (add bool)) ([]T, error) {
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		return []T(nil), err
+	}
+	switch sniffFormat(b) {
+	case "xlsx":
+		return ReadBinary(b, filterFunc...)
+	case "xls":
+		return ReadXLSBinary(b, filterFunc...)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}